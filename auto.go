@@ -0,0 +1,149 @@
+package csvparser
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// csvTag is the struct tag key that NewCsvParserFromBytesAuto and NewCsvParserFromReaderAuto
+// inspect to automatically wire up column parsers.
+const csvTag = "csv"
+
+// csvLayoutTag optionally overrides the time.Time layout used for a tagged field. It defaults
+// to time.RFC3339 when absent.
+const csvLayoutTag = "csvLayout"
+
+// CSVUnmarshaler is implemented by types that know how to parse themselves from a single CSV
+// cell value. Fields of a type implementing this interface are handled automatically by
+// NewCsvParserFromBytesAuto and NewCsvParserFromReaderAuto.
+type CSVUnmarshaler interface {
+	UnmarshalCSV(value string) error
+}
+
+var csvUnmarshalerType = reflect.TypeOf((*CSVUnmarshaler)(nil)).Elem()
+
+// NewCsvParserFromBytesAuto instantiates a new CsvParser from a []byte input, automatically
+// registering a ParserFunc for every field of ReadTo tagged with `csv:"column_name"`.
+// Supported field types are string, the int/uint family, float32/64, bool, time.Time (optionally
+// paired with a `csvLayout:"..."` tag), and any type implementing CSVUnmarshaler. Call
+// AddColumnParser afterwards to override the parser for any individual column.
+func NewCsvParserFromBytesAuto[ReadTo any](input []byte, headers ...string) (*CsvParser[ReadTo], error) {
+	reader := bytes.NewReader(input)
+	return NewCsvParserFromReaderAuto[ReadTo](reader, headers...)
+}
+
+// NewCsvParserFromReaderAuto instantiates a new CsvParser from an io.Reader, automatically
+// registering a ParserFunc for every field of ReadTo tagged with `csv:"column_name"`. See
+// NewCsvParserFromBytesAuto for the supported field types.
+func NewCsvParserFromReaderAuto[ReadTo any](input io.Reader, headers ...string) (*CsvParser[ReadTo], error) {
+	parser := NewCsvParserFromReader[ReadTo](input, headers...)
+	if err := registerAutoParsers(parser); err != nil {
+		return nil, err
+	}
+	return parser, nil
+}
+
+// registerAutoParsers walks the fields of ReadTo and registers a column parser for each one
+// tagged with `csv:"..."`.
+func registerAutoParsers[ReadTo any](parser *CsvParser[ReadTo]) error {
+	t := reflect.TypeOf(*new(ReadTo))
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("csvparser: automatic registration requires a struct type, got %v", t)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		column, ok := field.Tag.Lookup(csvTag)
+		if !ok || column == "-" {
+			continue
+		}
+		columnParser, err := autoParserFor[ReadTo](field, field.Index)
+		if err != nil {
+			return err
+		}
+		parser.AddColumnParser(column, columnParser)
+	}
+	return nil
+}
+
+// autoParserFor builds a ParserFunc for a single struct field, based on its type and tags.
+func autoParserFor[ReadTo any](field reflect.StructField, index []int) (ParserFunc[ReadTo], error) {
+	fieldType := field.Type
+
+	if reflect.PointerTo(fieldType).Implements(csvUnmarshalerType) {
+		return func(value string, destination *ReadTo) error {
+			fieldValue := reflect.ValueOf(destination).Elem().FieldByIndex(index)
+			return fieldValue.Addr().Interface().(CSVUnmarshaler).UnmarshalCSV(value)
+		}, nil
+	}
+
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		layout := field.Tag.Get(csvLayoutTag)
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return func(value string, destination *ReadTo) error {
+			parsed, err := time.Parse(layout, value)
+			if err != nil {
+				return err
+			}
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).Set(reflect.ValueOf(parsed))
+			return nil
+		}, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return func(value string, destination *ReadTo) error {
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).SetString(value)
+			return nil
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bitSize := fieldType.Bits()
+		return func(value string, destination *ReadTo) error {
+			parsed, err := strconv.ParseInt(value, 10, bitSize)
+			if err != nil {
+				return err
+			}
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).SetInt(parsed)
+			return nil
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bitSize := fieldType.Bits()
+		return func(value string, destination *ReadTo) error {
+			parsed, err := strconv.ParseUint(value, 10, bitSize)
+			if err != nil {
+				return err
+			}
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).SetUint(parsed)
+			return nil
+		}, nil
+	case reflect.Float32, reflect.Float64:
+		return func(value string, destination *ReadTo) error {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).SetFloat(parsed)
+			return nil
+		}, nil
+	case reflect.Bool:
+		return func(value string, destination *ReadTo) error {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			reflect.ValueOf(destination).Elem().FieldByIndex(index).SetBool(parsed)
+			return nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("csvparser: automatic parsing isn't supported for field %q of type %s", field.Name, fieldType)
+}
@@ -0,0 +1,74 @@
+package csvparser
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type autoID struct {
+	value int
+}
+
+func (a *autoID) UnmarshalCSV(value string) error {
+	a.value = len(value)
+	return nil
+}
+
+type autoRow struct {
+	Name      string    `csv:"name"`
+	Age       int8      `csv:"age"`
+	CreatedAt time.Time `csv:"created_at" csvLayout:"2006-01-02"`
+	ID        autoID    `csv:"id"`
+	Ignored   string
+}
+
+func TestNewCsvParserFromReaderAuto(t *testing.T) {
+	data := "name,age,created_at,id\nava,30,2024-01-02,abcde\n"
+	parser, err := NewCsvParserFromReaderAuto[autoRow](strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewCsvParserFromReaderAuto returned error: %v", err)
+	}
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(result))
+	}
+
+	row := result[0]
+	if row.Name != "ava" {
+		t.Errorf("Name = %q, want %q", row.Name, "ava")
+	}
+	if row.Age != 30 {
+		t.Errorf("Age = %d, want 30", row.Age)
+	}
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !row.CreatedAt.Equal(want) {
+		t.Errorf("CreatedAt = %v, want %v", row.CreatedAt, want)
+	}
+	if row.ID.value != len("abcde") {
+		t.Errorf("ID.value = %d, want %d", row.ID.value, len("abcde"))
+	}
+}
+
+func TestNewCsvParserFromReaderAutoRejectsOutOfRangeInt(t *testing.T) {
+	data := "name,age,created_at,id\nava,300,2024-01-02,abcde\n"
+	parser, err := NewCsvParserFromReaderAuto[autoRow](strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewCsvParserFromReaderAuto returned error: %v", err)
+	}
+	parser.TerminateOnParsingError()
+
+	if _, err := parser.Parse(); err == nil {
+		t.Fatal("expected an error for an int8 field overflowed by the column value, got nil")
+	}
+}
+
+func TestNewCsvParserFromReaderAutoRejectsNonStruct(t *testing.T) {
+	if _, err := NewCsvParserFromReaderAuto[int](strings.NewReader("a\n1\n")); err == nil {
+		t.Fatal("expected an error for a non-struct ReadTo, got nil")
+	}
+}
@@ -3,12 +3,18 @@ package csvparser
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
 )
 
+// errSkippedRow is an internal sentinel returned by readRowAndParseObject when a reader-level
+// error was recovered by the configured error handler, so callers should simply move on to the
+// next row without treating it as end-of-file or a parsing failure.
+var errSkippedRow = errors.New("csvparser: row skipped by error handler")
+
 // ParserFunc is the callback that will be called at each column parsing/reading
 // The value parameter is the column value, and the destination is the struct to add values from the parsing
 type ParserFunc[ReadTo any] func(value string, destination *ReadTo) error
@@ -29,6 +35,14 @@ type CsvParser[ReadTo any] struct {
 	onFinish                func()
 	onStart                 func()
 	terminateOnParsingError bool
+	headerNormalizer        func(string) string
+	lenientHeaders          bool
+	mismatchedHeaders       []string
+	missingParsers          []string
+	skipRows                int
+	errorHandler            func(*csv.ParseError) bool
+	headerless              bool
+	columnParsersByIndex    map[int]ParserFunc[ReadTo]
 }
 
 // NewCsvParserFromBytes instantiates a new CsvParser from a []byte input
@@ -51,6 +65,15 @@ func NewCsvParserFromReader[ReadTo any](input io.Reader, headers ...string) *Csv
 	}
 }
 
+// NewCsvParserHeaderless instantiates a new CsvParser for a file that has no header row at all.
+// Columns are registered with AddColumnParserAt, keyed by their zero-based position; header
+// preparation is skipped entirely and rows are routed straight to the parser for their index.
+func NewCsvParserHeaderless[ReadTo any](input io.Reader) *CsvParser[ReadTo] {
+	parser := NewCsvParserFromReader[ReadTo](input)
+	parser.headerless = true
+	return parser
+}
+
 // TerminateOnParsingError sets a flag to finish the parsing if a single row throws an error.
 // if flag is set to false, it will continue to parse and skip the record with the error.
 func (c *CsvParser[ReadTo]) TerminateOnParsingError() *CsvParser[ReadTo] {
@@ -88,6 +111,105 @@ func (c *CsvParser[ReadTo]) AddColumnParser(headerName string, parser ParserFunc
 	return c
 }
 
+// AddColumnParserAt registers a parser for a column by its zero-based position in the row,
+// instead of by header name. Used with NewCsvParserHeaderless: positional parsers are only
+// consulted in headerless mode, and a parser that reads or is given headers ignores them.
+func (c *CsvParser[ReadTo]) AddColumnParserAt(index int, parser ParserFunc[ReadTo]) *CsvParser[ReadTo] {
+	if c.columnParsersByIndex == nil {
+		c.columnParsersByIndex = map[int]ParserFunc[ReadTo]{}
+	}
+	c.columnParsersByIndex[index] = parser
+	return c
+}
+
+// WithHeaderNormalizer sets a function applied to both file headers and registered parser keys
+// before they're matched against each other, e.g. for case-insensitive or whitespace-tolerant
+// header matching.
+func (c *CsvParser[ReadTo]) WithHeaderNormalizer(normalizer func(string) string) *CsvParser[ReadTo] {
+	c.headerNormalizer = normalizer
+	return c
+}
+
+// WithLenientHeaders stops prepareHeaders from aborting on the first unparsable or unmatched
+// header. Instead, every mismatch is collected and can be inspected afterwards with
+// MismatchedHeaders and MissingParsers.
+func (c *CsvParser[ReadTo]) WithLenientHeaders() *CsvParser[ReadTo] {
+	c.lenientHeaders = true
+	return c
+}
+
+// MismatchedHeaders returns the file headers that had no registered parser. Only populated when
+// WithLenientHeaders is set.
+func (c *CsvParser[ReadTo]) MismatchedHeaders() []string {
+	return c.mismatchedHeaders
+}
+
+// MissingParsers returns the registered parser keys that never matched a file header. Only
+// populated when WithLenientHeaders is set.
+func (c *CsvParser[ReadTo]) MissingParsers() []string {
+	return c.missingParsers
+}
+
+// WithDelimiter sets the field delimiter used when reading, overriding the default comma.
+func (c *CsvParser[ReadTo]) WithDelimiter(delimiter rune) *CsvParser[ReadTo] {
+	c.fileReader.Comma = delimiter
+	return c
+}
+
+// WithComment sets a character that, when it appears as the first character of a line, marks
+// that line as a comment to be ignored.
+func (c *CsvParser[ReadTo]) WithComment(comment rune) *CsvParser[ReadTo] {
+	c.fileReader.Comment = comment
+	return c
+}
+
+// WithLazyQuotes allows a quote to appear in an unquoted field, and a non-doubled quote to
+// appear in a quoted field, matching encoding/csv's LazyQuotes option.
+func (c *CsvParser[ReadTo]) WithLazyQuotes(lazyQuotes bool) *CsvParser[ReadTo] {
+	c.fileReader.LazyQuotes = lazyQuotes
+	return c
+}
+
+// WithTrimLeadingSpace trims leading white space in a field, matching encoding/csv's
+// TrimLeadingSpace option.
+func (c *CsvParser[ReadTo]) WithTrimLeadingSpace(trim bool) *CsvParser[ReadTo] {
+	c.fileReader.TrimLeadingSpace = trim
+	return c
+}
+
+// WithFieldsPerRecord sets the expected number of fields per record, matching encoding/csv's
+// FieldsPerRecord option. The default, 0, requires every record to have as many fields as the
+// first one read.
+func (c *CsvParser[ReadTo]) WithFieldsPerRecord(fields int) *CsvParser[ReadTo] {
+	c.fileReader.FieldsPerRecord = fields
+	return c
+}
+
+// WithReuseRecord reuses the backing array of the slice returned by each row read, reducing
+// allocations. This matters most when rows are consumed one at a time, as in ParseStream, All,
+// and ParseParallel.
+func (c *CsvParser[ReadTo]) WithReuseRecord(reuse bool) *CsvParser[ReadTo] {
+	c.fileReader.ReuseRecord = reuse
+	return c
+}
+
+// WithSkipRows skips n preamble rows before the header row (or before data, if headers were
+// already provided to the constructor).
+func (c *CsvParser[ReadTo]) WithSkipRows(n int) *CsvParser[ReadTo] {
+	c.skipRows = n
+	return c
+}
+
+// WithErrorHandler sets a handler that decides, per reader-level csv.ParseError, whether the
+// row is skipped as recoverable (true) or the error is kept (false). A kept error is treated
+// like any other row error: it ends parsing only when TerminateOnParsingError is set, and the
+// row is skipped otherwise. This is distinct from OnParseError, which only fires for errors
+// returned by a registered ParserFunc, not the underlying csv.Reader.
+func (c *CsvParser[ReadTo]) WithErrorHandler(handler func(*csv.ParseError) bool) *CsvParser[ReadTo] {
+	c.errorHandler = handler
+	return c
+}
+
 // Parse returns an array of the object to return ([]ReadTo) from the input data and parsers provided.
 func (c *CsvParser[ReadTo]) Parse() ([]ReadTo, error) {
 	c.runOnStart()
@@ -103,9 +225,19 @@ func (c *CsvParser[ReadTo]) Parse() ([]ReadTo, error) {
 // prepareHeaders verifies if the headers and parsers are matched. If the headers are not passed in the constructor,
 // it will load the headers from the file data.
 func (c *CsvParser[ReadTo]) prepareHeaders() error {
+	if err := c.skipPreambleRows(); err != nil {
+		return err
+	}
+	if c.headerless {
+		return nil
+	}
 	if c.areHeadersEmpty() {
 		return c.loadHeadersFromFile()
 	}
+	if c.lenientHeaders {
+		c.collectHeaderMismatches()
+		return nil
+	}
 	header, existsUnparsableHeader := c.isThereAnUnparsableHeader()
 	if existsUnparsableHeader {
 		return newUnparsableHeaderErr(header)
@@ -113,6 +245,38 @@ func (c *CsvParser[ReadTo]) prepareHeaders() error {
 	return nil
 }
 
+// collectHeaderMismatches records every header with no registered parser, and every registered
+// parser that matches no header, instead of failing on the first mismatch.
+func (c *CsvParser[ReadTo]) collectHeaderMismatches() {
+	for _, header := range c.headers {
+		if !c.existsParserForHeader(header) {
+			c.mismatchedHeaders = append(c.mismatchedHeaders, header)
+		}
+	}
+	c.collectMissingParsers()
+}
+
+// collectMissingParsers records every registered parser key that doesn't match any loaded header.
+func (c *CsvParser[ReadTo]) collectMissingParsers() {
+	matched := map[string]bool{}
+	for _, header := range c.headers {
+		matched[c.normalizeHeader(header)] = true
+	}
+	for key := range c.columnParsers {
+		if !matched[c.normalizeHeader(key)] {
+			c.missingParsers = append(c.missingParsers, key)
+		}
+	}
+}
+
+// normalizeHeader applies the configured header normalizer, if any, to a header or parser key.
+func (c *CsvParser[ReadTo]) normalizeHeader(header string) string {
+	if c.headerNormalizer == nil {
+		return header
+	}
+	return c.headerNormalizer(header)
+}
+
 // areHeadersEmpty checks if the headers are empty
 func (c *CsvParser[ReadTo]) areHeadersEmpty() bool {
 	return len(c.headers) == 0
@@ -134,13 +298,30 @@ func (c *CsvParser[ReadTo]) existsParserForHeader(header string) bool {
 	return ok
 }
 
+// skipPreambleRows discards the rows configured via WithSkipRows before any header or data row
+// is read.
+func (c *CsvParser[ReadTo]) skipPreambleRows() error {
+	for i := 0; i < c.skipRows; i++ {
+		if _, err := c.fileReader.Read(); err != nil {
+			return parseError{Msg: fmt.Sprintf("couldn't skip preamble row: %s", err.Error())}
+		}
+	}
+	return nil
+}
+
 // loadHeadersFromFile reads the first row in the file and loads it into the headers
 func (c *CsvParser[ReadTo]) loadHeadersFromFile() error {
 	headers, err := c.fileReader.Read()
 	if err != nil {
 		return parseError{Msg: fmt.Sprintf("couldn't read headers from file: %s", err.Error())}
 	}
-	return c.loadHeaders(headers)
+	if err := c.loadHeaders(headers); err != nil {
+		return err
+	}
+	if c.lenientHeaders {
+		c.collectMissingParsers()
+	}
+	return nil
 }
 
 // loadHeaders loads a set of headers into the struct.
@@ -158,6 +339,11 @@ func (c *CsvParser[ReadTo]) loadHeaders(headers []string) error {
 func (c *CsvParser[ReadTo]) loadHeader(header string) error {
 	header = strings.Trim(header, " ")
 	if !c.isHeaderAbleToBeParsed(header) {
+		if c.lenientHeaders {
+			c.mismatchedHeaders = append(c.mismatchedHeaders, header)
+			c.headers = append(c.headers, header)
+			return nil
+		}
 		return newUnparsableHeaderErr(header)
 	}
 	c.headers = append(c.headers, header)
@@ -170,10 +356,22 @@ func (c *CsvParser[ReadTo]) isHeaderAbleToBeParsed(header string) bool {
 	return ok
 }
 
-// getParserFor gets a parser for a specific header.
+// getParserFor gets a parser for a specific header. If a header normalizer is configured, it
+// falls back to matching the normalized forms of the header and the registered parser keys.
 func (c *CsvParser[ReadTo]) getParserFor(header string) (ParserFunc[ReadTo], bool) {
-	res, ok := c.columnParsers[header]
-	return res, ok
+	if res, ok := c.columnParsers[header]; ok {
+		return res, ok
+	}
+	if c.headerNormalizer == nil {
+		return nil, false
+	}
+	normalizedHeader := c.normalizeHeader(header)
+	for key, parser := range c.columnParsers {
+		if c.normalizeHeader(key) == normalizedHeader {
+			return parser, true
+		}
+	}
+	return nil, false
 }
 
 // parseResults returns the slice of objects to be parsed from the .csv file.
@@ -184,6 +382,9 @@ func (c *CsvParser[ReadTo]) parseResults() ([]ReadTo, error) {
 		if err == io.EOF {
 			break
 		}
+		if err == errSkippedRow {
+			continue
+		}
 		if err != nil {
 			if !c.terminateOnParsingError {
 				continue
@@ -195,15 +396,34 @@ func (c *CsvParser[ReadTo]) parseResults() ([]ReadTo, error) {
 	return result, nil
 }
 
-// readRowAndParseObject reads a file row and parses it into an object.
+// readRowAndParseObject reads a file row and parses it into an object. If the row was a
+// reader-level error recovered by the configured error handler, it returns errSkippedRow so the
+// caller simply moves on to the next row.
 func (c *CsvParser[ReadTo]) readRowAndParseObject() (*ReadTo, error) {
-	row, err := c.fileReader.Read()
+	row, err := c.nextRow()
 	if err != nil {
 		return nil, err
 	}
+	if row == nil {
+		return nil, errSkippedRow
+	}
 	return c.parseRow(row)
 }
 
+// nextRow reads the next row from the file. A reader-level csv.ParseError is routed through the
+// configured error handler: if it reports the error as recoverable, nextRow returns (nil, nil)
+// so the row is simply skipped; otherwise the error is returned as-is.
+func (c *CsvParser[ReadTo]) nextRow() ([]string, error) {
+	row, err := c.fileReader.Read()
+	if err == nil || err == io.EOF {
+		return row, err
+	}
+	if parseErr, ok := err.(*csv.ParseError); ok && c.errorHandler != nil && c.errorHandler(parseErr) {
+		return nil, nil
+	}
+	return nil, err
+}
+
 // parseRow parses a single row into the target object. Runs the hook for the object if success.
 func (c *CsvParser[ReadTo]) parseRow(row []string) (*ReadTo, error) {
 	object := new(ReadTo)
@@ -238,10 +458,20 @@ func (c *CsvParser[ReadTo]) afterParsingHookExists() bool {
 	return c.afterParsingHook != nil
 }
 
-// parseColumns parses all the columns into a destination object.
+// parseColumns parses all the columns into a destination object. A row with more columns than
+// registered headers can reach here when WithFieldsPerRecord relaxes encoding/csv's default
+// field-count check, so columns past the last header are reported as an error rather than
+// indexed out of range.
 func (c *CsvParser[ReadTo]) parseColumns(row []string, destination *ReadTo) error {
 	for i, columnValue := range row {
-		err := c.parseColumn(columnValue, c.headers[i], destination)
+		var err error
+		if c.headerless {
+			err = c.parseColumnAt(i, columnValue, destination)
+		} else if i >= len(c.headers) {
+			err = parseError{Msg: fmt.Sprintf("row has more columns (%d) than headers (%d)", len(row), len(c.headers))}
+		} else {
+			err = c.parseColumn(columnValue, c.headers[i], destination)
+		}
 		if err != nil {
 			return err
 		}
@@ -250,9 +480,14 @@ func (c *CsvParser[ReadTo]) parseColumns(row []string, destination *ReadTo) erro
 }
 
 // parseColumn parses a single column. Uses columnParsers from the columnHeader to do it.
+// In lenient mode, a column with no registered parser is left untouched rather than failing
+// the whole row, since it's already reported separately via MismatchedHeaders.
 func (c *CsvParser[ReadTo]) parseColumn(columnValue, columnHeader string, destination *ReadTo) error {
 	parser, ok := c.getParserFor(columnHeader)
 	if !ok {
+		if c.lenientHeaders {
+			return nil
+		}
 		return newUnparsableHeaderErr(columnHeader)
 	}
 	if err := parser(columnValue, destination); err != nil {
@@ -261,6 +496,19 @@ func (c *CsvParser[ReadTo]) parseColumn(columnValue, columnHeader string, destin
 	return nil
 }
 
+// parseColumnAt parses a single column by its position. Used in headerless mode, where columns
+// are registered with AddColumnParserAt instead of AddColumnParser.
+func (c *CsvParser[ReadTo]) parseColumnAt(index int, columnValue string, destination *ReadTo) error {
+	parser, ok := c.columnParsersByIndex[index]
+	if !ok {
+		return newUnparsableHeaderErr(fmt.Sprintf("column %d", index))
+	}
+	if err := parser(columnValue, destination); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *CsvParser[ReadTo]) runOnStart() {
 	if c.onStart != nil {
 		c.onStart()
@@ -0,0 +1,145 @@
+package csvparser
+
+import (
+	"encoding/csv"
+	"testing"
+)
+
+type optionsRow struct {
+	A string
+	B string
+}
+
+func addOptionsParsers(parser *CsvParser[optionsRow]) *CsvParser[optionsRow] {
+	parser.AddColumnParser("a", func(value string, dest *optionsRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParser("b", func(value string, dest *optionsRow) error {
+		dest.B = value
+		return nil
+	})
+	return parser
+}
+
+func TestWithDelimiterUsesCustomSeparator(t *testing.T) {
+	data := "a;b\n1;2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithDelimiter(';'))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithCommentIgnoresCommentedLines(t *testing.T) {
+	data := "a,b\n# this is a comment\n1,2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithComment('#'))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithLazyQuotesAllowsBareQuoteInField(t *testing.T) {
+	data := "a,b\n1\",2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithLazyQuotes(true))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1\"" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithTrimLeadingSpaceTrimsFields(t *testing.T) {
+	data := "a,b\n1,  2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithTrimLeadingSpace(true))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithReuseRecordDoesNotCorruptParsedValues(t *testing.T) {
+	data := "a,b\n1,2\n3,4\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithReuseRecord(true))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 2 || result[0].A != "1" || result[0].B != "2" || result[1].A != "3" || result[1].B != "4" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithSkipRowsSkipsPreambleBeforeHeaderRow(t *testing.T) {
+	// Preamble rows must have the same field count as the header row: they're read through the
+	// same csv.Reader, whose default FieldsPerRecord behavior pins the expected count to
+	// whichever row is read first.
+	data := "generated by,acme inc\n2024-01-01,-\na,b\n1,2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).WithSkipRows(2))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithSkipRowsWithProvidedHeaders(t *testing.T) {
+	data := "generated by,acme inc\n1,2\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data), "a", "b").WithSkipRows(1))
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithErrorHandlerRecoversFromParseError(t *testing.T) {
+	data := "a,b\n1,2\n3,4,5\n6,7\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)))
+	parser.WithErrorHandler(func(err *csv.ParseError) bool {
+		return true
+	})
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 2 || result[0].A != "1" || result[1].A != "6" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWithErrorHandlerFatalWhenHandlerReturnsFalse(t *testing.T) {
+	data := "a,b\n1,2\n3,4,5\n6,7\n"
+	parser := addOptionsParsers(NewCsvParserFromBytes[optionsRow]([]byte(data)).TerminateOnParsingError())
+	parser.WithErrorHandler(func(err *csv.ParseError) bool {
+		return false
+	})
+
+	if _, err := parser.Parse(); err == nil {
+		t.Fatal("expected an error when the handler declines to recover, got nil")
+	}
+}
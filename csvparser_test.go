@@ -0,0 +1,134 @@
+package csvparser
+
+import (
+	"strings"
+	"testing"
+)
+
+type fieldsPerRecordRow struct {
+	A string
+	B string
+}
+
+func TestParseRowWithMoreColumnsThanHeadersWhenFieldsPerRecordRelaxed(t *testing.T) {
+	data := "a,b\n1,2,3,4\n"
+	parser := NewCsvParserFromBytes[fieldsPerRecordRow]([]byte(data)).WithFieldsPerRecord(-1).TerminateOnParsingError()
+	parser.AddColumnParser("a", func(value string, dest *fieldsPerRecordRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParser("b", func(value string, dest *fieldsPerRecordRow) error {
+		dest.B = value
+		return nil
+	})
+
+	if _, err := parser.Parse(); err == nil {
+		t.Fatal("expected an error for a row with more columns than headers, got nil")
+	}
+}
+
+func TestParseSkipsOverlongRowWhenNotTerminating(t *testing.T) {
+	data := "a,b\n1,2,3,4\n5,6\n"
+	parser := NewCsvParserFromBytes[fieldsPerRecordRow]([]byte(data)).WithFieldsPerRecord(-1)
+	parser.AddColumnParser("a", func(value string, dest *fieldsPerRecordRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParser("b", func(value string, dest *fieldsPerRecordRow) error {
+		dest.B = value
+		return nil
+	})
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "5" || result[0].B != "6" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+type lenientRow struct {
+	A string
+}
+
+func TestWithLenientHeadersCollectsMismatches(t *testing.T) {
+	data := "a,b\n1,2\n"
+	parser := NewCsvParserFromBytes[lenientRow]([]byte(data)).WithLenientHeaders()
+	parser.AddColumnParser("a", func(value string, dest *lenientRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParser("c", func(value string, dest *lenientRow) error {
+		return nil
+	})
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if got := parser.MismatchedHeaders(); len(got) != 1 || got[0] != "b" {
+		t.Fatalf("MismatchedHeaders() = %v, want [b]", got)
+	}
+	if got := parser.MissingParsers(); len(got) != 1 || got[0] != "c" {
+		t.Fatalf("MissingParsers() = %v, want [c]", got)
+	}
+}
+
+func TestWithHeaderNormalizerMatchesCaseInsensitively(t *testing.T) {
+	data := "A,B\n1,2\n"
+	parser := NewCsvParserFromBytes[fieldsPerRecordRow]([]byte(data)).WithHeaderNormalizer(strings.ToLower)
+	parser.AddColumnParser("a", func(value string, dest *fieldsPerRecordRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParser("b", func(value string, dest *fieldsPerRecordRow) error {
+		dest.B = value
+		return nil
+	})
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 1 || result[0].A != "1" || result[0].B != "2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestNewCsvParserHeaderlessParsesByColumnIndex(t *testing.T) {
+	data := "1,ava\n2,ben\n"
+	parser := NewCsvParserHeaderless[fieldsPerRecordRow](strings.NewReader(data))
+	parser.AddColumnParserAt(0, func(value string, dest *fieldsPerRecordRow) error {
+		dest.A = value
+		return nil
+	})
+	parser.AddColumnParserAt(1, func(value string, dest *fieldsPerRecordRow) error {
+		dest.B = value
+		return nil
+	})
+
+	result, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(result) != 2 || result[0].A != "1" || result[0].B != "ava" || result[1].A != "2" || result[1].B != "ben" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestNewCsvParserHeaderlessErrorsOnUnregisteredIndex(t *testing.T) {
+	data := "1,ava\n"
+	parser := NewCsvParserHeaderless[fieldsPerRecordRow](strings.NewReader(data)).TerminateOnParsingError()
+	parser.AddColumnParserAt(0, func(value string, dest *fieldsPerRecordRow) error {
+		dest.A = value
+		return nil
+	})
+
+	if _, err := parser.Parse(); err == nil {
+		t.Fatal("expected an error for the column with no parser registered at index 1, got nil")
+	}
+}
@@ -0,0 +1,29 @@
+package csvparser
+
+import "fmt"
+
+// parseError represents a failure to read or parse a row of the CSV file.
+type parseError struct {
+	Msg string
+}
+
+// Error implements the error interface.
+func (e parseError) Error() string {
+	return e.Msg
+}
+
+// newparseError wraps an arbitrary error from the parsing pipeline as a parseError.
+func newparseError(err error) error {
+	return parseError{Msg: err.Error()}
+}
+
+// newUnparsableHeaderErr builds a parseError for a header with no registered parser.
+func newUnparsableHeaderErr(header string) error {
+	return parseError{Msg: fmt.Sprintf("no parser registered for header %q", header)}
+}
+
+// newUnparsableSerializerErr builds a parseError for a CsvWriter header with no registered
+// serializer.
+func newUnparsableSerializerErr(header string) error {
+	return parseError{Msg: fmt.Sprintf("no serializer registered for header %q", header)}
+}
@@ -0,0 +1,201 @@
+package csvparser
+
+import (
+	"container/heap"
+	"io"
+	"sync"
+)
+
+// reorderWindow bounds how many rows may be in flight (read but not yet emitted in order) at
+// once, so a slow collector applies back-pressure to the reader goroutine instead of letting it
+// race arbitrarily far ahead.
+const reorderWindow = 1024
+
+// parallelJob is a single row handed off to a worker goroutine, tagged with its original
+// position in the file so results can be reassembled in order.
+type parallelJob struct {
+	seq int
+	row []string
+}
+
+// parallelResult is a worker's output for one parallelJob.
+type parallelResult[ReadTo any] struct {
+	seq    int
+	row    []string
+	object *ReadTo
+	err    error
+}
+
+// orderedResultHeap is a min-heap of parallelResult, ordered by sequence number, used to
+// reassemble worker output in original row order.
+type orderedResultHeap[ReadTo any] []parallelResult[ReadTo]
+
+func (h orderedResultHeap[ReadTo]) Len() int            { return len(h) }
+func (h orderedResultHeap[ReadTo]) Less(i, j int) bool  { return h[i].seq < h[j].seq }
+func (h orderedResultHeap[ReadTo]) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *orderedResultHeap[ReadTo]) Push(x interface{}) { *h = append(*h, x.(parallelResult[ReadTo])) }
+func (h *orderedResultHeap[ReadTo]) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// ParseParallel behaves like Parse, but dispatches row parsing round-robin across a pool of
+// workers for CPU-bound ParserFuncs (regex parsing, JSON-in-a-cell, crypto, etc.), then
+// reassembles the results in original row order before returning. AfterEachParsingHook runs on
+// the collecting goroutine, in input order, so downstream consumers still see a deterministic
+// stream; OnParseError and TerminateOnParsingError behave the same as in Parse, with the
+// original row preserved for the callback.
+//
+// ParserFunc must be safe for concurrent invocation on distinct *ReadTo destinations.
+func (c *CsvParser[ReadTo]) ParseParallel(workers int) ([]ReadTo, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	c.runOnStart()
+	defer c.runOnFinish()
+
+	if err := c.prepareHeaders(); err != nil {
+		return []ReadTo{}, err
+	}
+
+	jobs := make([]chan parallelJob, workers)
+	for i := range jobs {
+		jobs[i] = make(chan parallelJob, 1)
+	}
+	results := make(chan parallelResult[ReadTo], workers)
+	tokens := make(chan struct{}, reorderWindow)
+	readErr := make(chan error, 1)
+	stop := make(chan struct{})
+
+	var workerWg sync.WaitGroup
+	workerWg.Add(workers)
+	for i := 0; i < workers; i++ {
+		workerJobs := jobs[i]
+		go func() {
+			defer workerWg.Done()
+			for job := range workerJobs {
+				object, err := c.parseRowConcurrently(job.row)
+				results <- parallelResult[ReadTo]{seq: job.seq, row: job.row, object: object, err: err}
+			}
+		}()
+	}
+	go func() {
+		workerWg.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer func() {
+			for _, workerJobs := range jobs {
+				close(workerJobs)
+			}
+		}()
+		seq := 0
+		for {
+			select {
+			case <-stop:
+				readErr <- nil
+				return
+			default:
+			}
+
+			row, err := c.nextRow()
+			if err == io.EOF {
+				readErr <- nil
+				return
+			}
+			if err != nil {
+				// A reader-level error behaves like it does in the sequential path: skip the
+				// bad row and keep going unless TerminateOnParsingError is set.
+				if !c.terminateOnParsingError {
+					continue
+				}
+				readErr <- err
+				return
+			}
+			if row == nil {
+				continue
+			}
+
+			select {
+			case tokens <- struct{}{}:
+			case <-stop:
+				readErr <- nil
+				return
+			}
+			select {
+			case jobs[seq%workers] <- parallelJob{seq: seq, row: append([]string(nil), row...)}:
+			case <-stop:
+				readErr <- nil
+				return
+			}
+			seq++
+		}
+	}()
+
+	return c.collectOrdered(results, readErr, tokens, stop)
+}
+
+// collectOrdered drains the workers' results channel, reassembling rows in original order via a
+// min-heap, running the after-parsing hook and error callback in that order, and releasing a
+// reorder-window token per row once it's been emitted. Once a terminal error is found, it closes
+// stop so the reader goroutine stops dispatching new jobs, while still draining whatever is
+// already in flight so no worker or collector goroutine is left running.
+func (c *CsvParser[ReadTo]) collectOrdered(results <-chan parallelResult[ReadTo], readErr <-chan error, tokens <-chan struct{}, stop chan struct{}) ([]ReadTo, error) {
+	pending := &orderedResultHeap[ReadTo]{}
+	heap.Init(pending)
+	nextSeq := 0
+	out := make([]ReadTo, 0)
+	var firstErr error
+	stopped := false
+
+	for res := range results {
+		heap.Push(pending, res)
+		for pending.Len() > 0 && (*pending)[0].seq == nextSeq {
+			item := heap.Pop(pending).(parallelResult[ReadTo])
+			<-tokens
+			nextSeq++
+
+			if firstErr != nil {
+				// Like Parse, nothing past the terminal error reaches the hooks.
+				continue
+			}
+			if item.err != nil {
+				c.runOnError(item.row, item.err)
+				if firstErr == nil && c.terminateOnParsingError {
+					firstErr = newparseError(item.err)
+					if !stopped {
+						stopped = true
+						close(stop)
+					}
+				}
+				continue
+			}
+			c.runAfterParsingHook(item.object)
+			out = append(out, *item.object)
+		}
+	}
+
+	if firstErr != nil {
+		return []ReadTo{}, firstErr
+	}
+	if err := <-readErr; err != nil {
+		return []ReadTo{}, newparseError(err)
+	}
+	return out, nil
+}
+
+// parseRowConcurrently parses a single row into a new destination object, without running the
+// after-parsing or error hooks: those must run on the collecting goroutine, in original row
+// order.
+func (c *CsvParser[ReadTo]) parseRowConcurrently(row []string) (*ReadTo, error) {
+	object := new(ReadTo)
+	if err := c.parseColumns(row, object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
@@ -0,0 +1,157 @@
+package csvparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type parallelRow struct {
+	ID int
+}
+
+func buildParallelCSV(rows int) []byte {
+	var b strings.Builder
+	b.WriteString("id\n")
+	for i := 0; i < rows; i++ {
+		fmt.Fprintf(&b, "%d\n", i)
+	}
+	return []byte(b.String())
+}
+
+func parseParallelID(value string, dest *parallelRow) error {
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	dest.ID = id
+	return nil
+}
+
+func TestParseParallelPreservesOrder(t *testing.T) {
+	const rows = 500
+	parser := NewCsvParserFromBytes[parallelRow](buildParallelCSV(rows))
+	parser.AddColumnParser("id", func(value string, dest *parallelRow) error {
+		if err := parseParallelID(value, dest); err != nil {
+			return err
+		}
+		// Variable-length busy work so workers finish out of input order.
+		for i := 0; i < dest.ID%7; i++ {
+			_ = i * i
+		}
+		return nil
+	})
+
+	result, err := parser.ParseParallel(8)
+	if err != nil {
+		t.Fatalf("ParseParallel returned error: %v", err)
+	}
+	if len(result) != rows {
+		t.Fatalf("expected %d rows, got %d", rows, len(result))
+	}
+	for i, row := range result {
+		if row.ID != i {
+			t.Fatalf("row %d out of order: got ID %d", i, row.ID)
+		}
+	}
+}
+
+func TestParseParallelHookRunsInOrder(t *testing.T) {
+	const rows = 200
+	parser := NewCsvParserFromBytes[parallelRow](buildParallelCSV(rows))
+	parser.AddColumnParser("id", parseParallelID)
+
+	var seen []int
+	parser.AfterEachParsingHook(func(row parallelRow) {
+		seen = append(seen, row.ID)
+	})
+
+	if _, err := parser.ParseParallel(4); err != nil {
+		t.Fatalf("ParseParallel returned error: %v", err)
+	}
+	for i, id := range seen {
+		if id != i {
+			t.Fatalf("hook invoked out of order at position %d: got ID %d", i, id)
+		}
+	}
+}
+
+func TestParseParallelTerminatesOnParsingError(t *testing.T) {
+	data := "id\n1\nnot-a-number\n3\n"
+	parser := NewCsvParserFromBytes[parallelRow]([]byte(data)).TerminateOnParsingError()
+	parser.AddColumnParser("id", parseParallelID)
+
+	if _, err := parser.ParseParallel(4); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestParseParallelSkipsReaderErrorsWhenNotTerminating(t *testing.T) {
+	data := "id\n1\n2,3\n6\n"
+	parser := NewCsvParserFromBytes[parallelRow]([]byte(data))
+	parser.AddColumnParser("id", parseParallelID)
+
+	result, err := parser.ParseParallel(4)
+	if err != nil {
+		t.Fatalf("ParseParallel returned error: %v", err)
+	}
+	if len(result) != 2 || result[0].ID != 1 || result[1].ID != 6 {
+		t.Fatalf("unexpected rows: %+v", result)
+	}
+}
+
+func TestParseParallelTerminatesOnReaderError(t *testing.T) {
+	data := "id\n1\n2,3\n6\n"
+	parser := NewCsvParserFromBytes[parallelRow]([]byte(data)).TerminateOnParsingError()
+	parser.AddColumnParser("id", parseParallelID)
+
+	if _, err := parser.ParseParallel(4); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestParseParallelStopsDispatchingAfterTerminalError(t *testing.T) {
+	const rows = 5000
+	data := buildParallelCSV(rows)
+	parser := NewCsvParserFromBytes[parallelRow](data).TerminateOnParsingError()
+
+	var parsed int32
+	parser.AddColumnParser("id", func(value string, dest *parallelRow) error {
+		atomic.AddInt32(&parsed, 1)
+		if value == "1" {
+			return fmt.Errorf("boom")
+		}
+		return parseParallelID(value, dest)
+	})
+
+	if _, err := parser.ParseParallel(2); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if got := atomic.LoadInt32(&parsed); got >= rows {
+		t.Fatalf("reader kept dispatching after the terminal error: parsed %d of %d rows", got, rows)
+	}
+}
+
+func TestParseParallelSkipsBadRowsWhenNotTerminating(t *testing.T) {
+	data := "id\n1\nnot-a-number\n3\n"
+	parser := NewCsvParserFromBytes[parallelRow]([]byte(data))
+
+	var errCount int32
+	parser.OnParseError(func(row []string, err error) {
+		atomic.AddInt32(&errCount, 1)
+	})
+	parser.AddColumnParser("id", parseParallelID)
+
+	result, err := parser.ParseParallel(4)
+	if err != nil {
+		t.Fatalf("ParseParallel returned error: %v", err)
+	}
+	if len(result) != 2 || result[0].ID != 1 || result[1].ID != 3 {
+		t.Fatalf("unexpected rows: %+v", result)
+	}
+	if atomic.LoadInt32(&errCount) != 1 {
+		t.Fatalf("expected OnParseError to run once, got %d", errCount)
+	}
+}
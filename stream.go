@@ -0,0 +1,99 @@
+package csvparser
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// ParseStream reads and parses the file one row at a time, emitting parsed objects and errors
+// on the returned channels as they become available, instead of buffering the whole result in
+// memory. Parsing stops and both channels are closed once the file is exhausted, ctx is
+// canceled, or (when TerminateOnParsingError is set) a row fails to parse. AfterEachParsingHook,
+// OnParseError, and TerminateOnParsingError behave the same as they do in Parse.
+func (c *CsvParser[ReadTo]) ParseStream(ctx context.Context) (<-chan ReadTo, <-chan error) {
+	results := make(chan ReadTo)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(results)
+		defer close(errs)
+		c.runOnStart()
+		defer c.runOnFinish()
+
+		if err := c.prepareHeaders(); err != nil {
+			errs <- err
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			default:
+			}
+
+			object, err := c.readRowAndParseObject()
+			if err == io.EOF {
+				return
+			}
+			if err == errSkippedRow {
+				continue
+			}
+			if err != nil {
+				if c.terminateOnParsingError {
+					errs <- newparseError(err)
+					return
+				}
+				continue
+			}
+
+			select {
+			case results <- *object:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return results, errs
+}
+
+// All returns an iter.Seq2 over the parsed rows and parse errors, suitable for range-over-func,
+// e.g. `for row, err := range parser.All() { ... }`. Iteration stops as soon as the consumer
+// breaks out of the loop, or on the first error when TerminateOnParsingError is set.
+func (c *CsvParser[ReadTo]) All() iter.Seq2[ReadTo, error] {
+	return func(yield func(ReadTo, error) bool) {
+		c.runOnStart()
+		defer c.runOnFinish()
+
+		if err := c.prepareHeaders(); err != nil {
+			var zero ReadTo
+			yield(zero, err)
+			return
+		}
+
+		for {
+			object, err := c.readRowAndParseObject()
+			if err == io.EOF {
+				return
+			}
+			if err == errSkippedRow {
+				continue
+			}
+			if err != nil {
+				if c.terminateOnParsingError {
+					var zero ReadTo
+					yield(zero, newparseError(err))
+					return
+				}
+				continue
+			}
+			if !yield(*object, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package csvparser
+
+import (
+	"context"
+	"strconv"
+	"testing"
+)
+
+type streamRow struct {
+	ID int
+}
+
+func parseStreamID(value string, dest *streamRow) error {
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	dest.ID = id
+	return nil
+}
+
+func TestParseStreamYieldsRowsInOrder(t *testing.T) {
+	data := "id\n1\n2\n3\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data))
+	parser.AddColumnParser("id", parseStreamID)
+
+	results, errs := parser.ParseStream(context.Background())
+
+	var got []int
+	for row := range results {
+		got = append(got, row.ID)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("ParseStream returned error: %v", err)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("unexpected rows: %v", got)
+	}
+}
+
+func TestParseStreamTerminatesOnParsingError(t *testing.T) {
+	data := "id\n1\nnot-a-number\n3\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data)).TerminateOnParsingError()
+	parser.AddColumnParser("id", parseStreamID)
+
+	results, errs := parser.ParseStream(context.Background())
+
+	var got []int
+	for row := range results {
+		got = append(got, row.ID)
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if len(got) != 1 || got[0] != 1 {
+		t.Fatalf("unexpected rows before the error: %v", got)
+	}
+}
+
+func TestParseStreamStopsOnContextCancel(t *testing.T) {
+	data := "id\n1\n2\n3\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data))
+	parser.AddColumnParser("id", parseStreamID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, errs := parser.ParseStream(ctx)
+	for range results {
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("expected a context cancellation error, got nil")
+	}
+}
+
+func TestAllIteratesParsedRows(t *testing.T) {
+	data := "id\n1\n2\n3\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data))
+	parser.AddColumnParser("id", parseStreamID)
+
+	var got []int
+	var iterErr error
+	for row, err := range parser.All() {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		got = append(got, row.ID)
+	}
+	if iterErr != nil {
+		t.Fatalf("All returned error: %v", iterErr)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("unexpected rows: %v", got)
+	}
+}
+
+func TestAllStopsOnBreak(t *testing.T) {
+	data := "id\n1\n2\n3\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data))
+	parser.AddColumnParser("id", parseStreamID)
+
+	var got []int
+	for row, err := range parser.All() {
+		if err != nil {
+			t.Fatalf("All returned error: %v", err)
+		}
+		got = append(got, row.ID)
+		if row.ID == 2 {
+			break
+		}
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected iteration to stop after 2 rows, got %v", got)
+	}
+}
+
+func TestAllYieldsErrorOnUnparsableHeader(t *testing.T) {
+	data := "id,extra\n1,2\n"
+	parser := NewCsvParserFromBytes[streamRow]([]byte(data))
+	parser.AddColumnParser("id", parseStreamID)
+
+	var sawErr bool
+	for _, err := range parser.All() {
+		if err != nil {
+			sawErr = true
+		}
+	}
+	if !sawErr {
+		t.Fatal("expected an error for the unparsable 'extra' header, got none")
+	}
+}
@@ -0,0 +1,172 @@
+package csvparser
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+)
+
+// SerializerFunc is the callback that will be called for each column when writing a row.
+// It receives the source object and returns the string value to place in that column.
+type SerializerFunc[WriteFrom any] func(src WriteFrom) (string, error)
+
+// BeforeSerializingRowFunc is a hook that runs before each row is serialized.
+type BeforeSerializingRowFunc[WriteFrom any] func(object WriteFrom)
+
+// OnSerializeErrorFunc is a callback that will run after every serialization error.
+type OnSerializeErrorFunc[WriteFrom any] func(object WriteFrom, err error)
+
+// CsvWriter is the encoding counterpart to CsvParser: it serializes a slice or stream of
+// WriteFrom objects into CSV, one registered column serializer at a time.
+type CsvWriter[WriteFrom any] struct {
+	headers           []string
+	columnSerializers map[string]SerializerFunc[WriteFrom]
+	beforeRowHook     BeforeSerializingRowFunc[WriteFrom]
+	onError           OnSerializeErrorFunc[WriteFrom]
+	skipHeaderRow     bool
+	delimiter         rune
+}
+
+// NewCsvWriter instantiates a new CsvWriter that will emit the given headers, in order, as
+// columns. Register a serializer for each header with AddColumnSerializer.
+func NewCsvWriter[WriteFrom any](headers ...string) *CsvWriter[WriteFrom] {
+	return &CsvWriter[WriteFrom]{
+		headers:           headers,
+		columnSerializers: map[string]SerializerFunc[WriteFrom]{},
+	}
+}
+
+// AddColumnSerializer adds a serializer for a header to the internal serializer list.
+func (c *CsvWriter[WriteFrom]) AddColumnSerializer(header string, serializer SerializerFunc[WriteFrom]) *CsvWriter[WriteFrom] {
+	c.columnSerializers[header] = serializer
+	return c
+}
+
+// BeforeEachRowHook adds a handler that will run before every single row is serialized.
+func (c *CsvWriter[WriteFrom]) BeforeEachRowHook(handler BeforeSerializingRowFunc[WriteFrom]) *CsvWriter[WriteFrom] {
+	c.beforeRowHook = handler
+	return c
+}
+
+// OnSerializeError sets a callback that is run after a row fails to serialize.
+func (c *CsvWriter[WriteFrom]) OnSerializeError(callback OnSerializeErrorFunc[WriteFrom]) *CsvWriter[WriteFrom] {
+	c.onError = callback
+	return c
+}
+
+// SkipHeaderRow stops Write and WriteStream from emitting the header row.
+func (c *CsvWriter[WriteFrom]) SkipHeaderRow() *CsvWriter[WriteFrom] {
+	c.skipHeaderRow = true
+	return c
+}
+
+// WithDelimiter sets the field delimiter used when writing, overriding the default comma.
+func (c *CsvWriter[WriteFrom]) WithDelimiter(delimiter rune) *CsvWriter[WriteFrom] {
+	c.delimiter = delimiter
+	return c
+}
+
+// Write serializes rows into w, one row per object, in header order.
+func (c *CsvWriter[WriteFrom]) Write(rows []WriteFrom, w io.Writer) error {
+	writer := c.newCsvWriter(w)
+	if err := c.writeHeaderRow(writer); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := c.writeRow(writer, row); err != nil {
+			writer.Flush()
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteStream serializes rows as they arrive on the rows channel, stopping early if ctx is
+// canceled.
+func (c *CsvWriter[WriteFrom]) WriteStream(ctx context.Context, rows <-chan WriteFrom, w io.Writer) error {
+	writer := c.newCsvWriter(w)
+	if err := c.writeHeaderRow(writer); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			writer.Flush()
+			return ctx.Err()
+		case row, ok := <-rows:
+			if !ok {
+				writer.Flush()
+				return writer.Error()
+			}
+			if err := c.writeRow(writer, row); err != nil {
+				writer.Flush()
+				return err
+			}
+		}
+	}
+}
+
+// newCsvWriter builds the underlying encoding/csv.Writer, applying any configured dialect
+// settings.
+func (c *CsvWriter[WriteFrom]) newCsvWriter(w io.Writer) *csv.Writer {
+	writer := csv.NewWriter(w)
+	if c.delimiter != 0 {
+		writer.Comma = c.delimiter
+	}
+	return writer
+}
+
+// writeHeaderRow emits the header row, unless SkipHeaderRow was set.
+func (c *CsvWriter[WriteFrom]) writeHeaderRow(writer *csv.Writer) error {
+	if c.skipHeaderRow {
+		return nil
+	}
+	return writer.Write(c.headers)
+}
+
+// writeRow runs the before-row hook, serializes a single object, and writes the resulting
+// record.
+func (c *CsvWriter[WriteFrom]) writeRow(writer *csv.Writer, row WriteFrom) error {
+	c.runBeforeRowHook(row)
+	record, err := c.serializeColumns(row)
+	if err != nil {
+		c.runOnError(row, err)
+		return err
+	}
+	return writer.Write(record)
+}
+
+// serializeColumns serializes every registered column, in header order, for a single object.
+func (c *CsvWriter[WriteFrom]) serializeColumns(row WriteFrom) ([]string, error) {
+	record := make([]string, len(c.headers))
+	for i, header := range c.headers {
+		value, err := c.serializeColumn(header, row)
+		if err != nil {
+			return nil, err
+		}
+		record[i] = value
+	}
+	return record, nil
+}
+
+// serializeColumn serializes a single column. Uses columnSerializers from the header to do it.
+func (c *CsvWriter[WriteFrom]) serializeColumn(header string, row WriteFrom) (string, error) {
+	serializer, ok := c.columnSerializers[header]
+	if !ok {
+		return "", newUnparsableSerializerErr(header)
+	}
+	return serializer(row)
+}
+
+func (c *CsvWriter[WriteFrom]) runBeforeRowHook(row WriteFrom) {
+	if c.beforeRowHook != nil {
+		c.beforeRowHook(row)
+	}
+}
+
+func (c *CsvWriter[WriteFrom]) runOnError(row WriteFrom, err error) {
+	if c.onError != nil {
+		c.onError(row, err)
+	}
+}
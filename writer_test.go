@@ -0,0 +1,123 @@
+package csvparser
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type writerRow struct {
+	ID   int
+	Name string
+}
+
+func TestCsvWriterWriteProducesExpectedOutput(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id", "name")
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		return strconv.Itoa(src.ID), nil
+	})
+	writer.AddColumnSerializer("name", func(src writerRow) (string, error) {
+		return src.Name, nil
+	})
+
+	var buf strings.Builder
+	rows := []writerRow{{ID: 1, Name: "ava"}, {ID: 2, Name: "ben"}}
+	if err := writer.Write(rows, &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	want := "id,name\n1,ava\n2,ben\n"
+	if buf.String() != want {
+		t.Fatalf("Write() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestCsvWriterSkipHeaderRow(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id").SkipHeaderRow()
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		return strconv.Itoa(src.ID), nil
+	})
+
+	var buf strings.Builder
+	if err := writer.Write([]writerRow{{ID: 1}}, &buf); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if buf.String() != "1\n" {
+		t.Fatalf("Write() = %q, want %q", buf.String(), "1\n")
+	}
+}
+
+func TestCsvWriterWriteReturnsSerializerError(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id")
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		return "", fmt.Errorf("boom")
+	})
+
+	var seen error
+	writer.OnSerializeError(func(row writerRow, err error) {
+		seen = err
+	})
+
+	var buf strings.Builder
+	if err := writer.Write([]writerRow{{ID: 1}}, &buf); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if seen == nil {
+		t.Fatal("expected OnSerializeError to run, it did not")
+	}
+}
+
+func TestCsvWriterWriteFlushesRowsWrittenBeforeAnError(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id")
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		if src.ID == 2 {
+			return "", fmt.Errorf("boom")
+		}
+		return strconv.Itoa(src.ID), nil
+	})
+
+	var buf strings.Builder
+	rows := []writerRow{{ID: 1}, {ID: 2}, {ID: 3}}
+	if err := writer.Write(rows, &buf); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	want := "id\n1\n"
+	if buf.String() != want {
+		t.Fatalf("Write() left buf = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestCsvWriterWriteReturnsSerializerErrForMissingSerializer(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id", "name")
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		return strconv.Itoa(src.ID), nil
+	})
+
+	var buf strings.Builder
+	err := writer.Write([]writerRow{{ID: 1}}, &buf)
+	if err == nil {
+		t.Fatal("expected an error for the header with no registered serializer, got nil")
+	}
+	if strings.Contains(err.Error(), "parser") {
+		t.Fatalf("error message %q still refers to a \"parser\", want writer-specific wording", err.Error())
+	}
+}
+
+func TestCsvWriterWriteStreamRespectsContextCancel(t *testing.T) {
+	writer := NewCsvWriter[writerRow]("id")
+	writer.AddColumnSerializer("id", func(src writerRow) (string, error) {
+		return strconv.Itoa(src.ID), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rows := make(chan writerRow)
+	var buf strings.Builder
+	if err := writer.WriteStream(ctx, rows, &buf); err == nil {
+		t.Fatal("expected a context cancellation error, got nil")
+	}
+}